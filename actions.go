@@ -0,0 +1,135 @@
+package trello
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Action is a change recorded on a board, such as a card being created or
+// a list being renamed.
+type Action struct {
+	ID              string    `json:"id"`
+	Type            string    `json:"type"`
+	Date            time.Time `json:"date"`
+	IDMemberCreator string    `json:"idMemberCreator"`
+
+	// Data describes what the action touched; its fields depend on Type.
+	Data json.RawMessage `json:"data"`
+}
+
+// ActionsOption configures which actions Board.Actions and
+// Board.ActionsSince return and in what order.
+type ActionsOption func(*actionsOptions)
+
+type actionsOptions struct {
+	since       time.Time
+	before      time.Time
+	oldestFirst bool
+}
+
+// ActionsSinceTime limits actions to those after t.
+func ActionsSinceTime(t time.Time) ActionsOption {
+	return func(o *actionsOptions) {
+		o.since = t
+	}
+}
+
+// ActionsBeforeTime limits actions to those before t.
+func ActionsBeforeTime(t time.Time) ActionsOption {
+	return func(o *actionsOptions) {
+		o.before = t
+	}
+}
+
+// ActionsOldestFirst returns actions oldest first, for replaying them in
+// order. Without it actions are returned newest first, as Trello sends
+// them.
+func ActionsOldestFirst() ActionsOption {
+	return func(o *actionsOptions) {
+		o.oldestFirst = true
+	}
+}
+
+// params returns the query parameters for o's time window.
+func (o actionsOptions) params() url.Values {
+	params := url.Values{}
+	if !o.since.IsZero() {
+		params.Set("since", formatDate(o.since))
+	}
+	if !o.before.IsZero() {
+		params.Set("before", formatDate(o.before))
+	}
+	return params
+}
+
+// formatDate formats t the way Trello writes dates.
+func formatDate(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+func (b *board) Actions(opts ...ActionsOption) ([]Action, error) {
+	var o actionsOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return b.fetchActionsInOrder(o, o.params())
+}
+
+func (b *board) ActionsSince(actionID string, opts ...ActionsOption) ([]Action, error) {
+	var o actionsOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	params := o.params()
+	params.Set("since", actionID)
+	return b.fetchActionsInOrder(o, params)
+}
+
+// fetchActionsInOrder fetches the actions matching params in the order
+// o asks for.
+func (b *board) fetchActionsInOrder(o actionsOptions, params url.Values) ([]Action, error) {
+	actions, err := b.fetchActions(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.oldestFirst {
+		for i, j := 0, len(actions)-1; i < j; i, j = i+1, j-1 {
+			actions[i], actions[j] = actions[j], actions[i]
+		}
+	}
+
+	return actions, nil
+}
+
+// actionsPageSize is the most actions Trello returns in one request.
+const actionsPageSize = 1000
+
+// fetchActions retrieves every board action matching params, newest
+// first. Trello returns at most actionsPageSize actions per request, so
+// it pages backwards with before set to the oldest action seen until a
+// page comes back short.
+func (b *board) fetchActions(params url.Values) ([]Action, error) {
+	query := url.Values{}
+	for k, v := range params {
+		query[k] = v
+	}
+	query.Set("limit", strconv.Itoa(actionsPageSize))
+
+	var actions []Action
+	for {
+		var page []Action
+		if err := b.client.do("GET", "/1/boards/"+b.ID+"/actions", query, &page); err != nil {
+			return nil, err
+		}
+		actions = append(actions, page...)
+
+		if len(page) < actionsPageSize {
+			return actions, nil
+		}
+		query.Set("before", page[len(page)-1].ID)
+	}
+}
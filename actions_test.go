@@ -0,0 +1,53 @@
+package trello
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// actionPage returns a JSON page of n actions with ids counting down from
+// newest, as Trello orders them.
+func actionPage(newest, n int) string {
+	var page []Action
+	for i := 0; i < n; i++ {
+		page = append(page, Action{ID: fmt.Sprint(newest - i), Type: "updateList"})
+	}
+	data, _ := json.Marshal(page)
+	return string(data)
+}
+
+func TestBoardActionsSincePagesFullPages(t *testing.T) {
+	var befores []string
+	c := stubClient(t, func(r *http.Request) (int, string) {
+		q := r.URL.Query()
+		if q.Get("since") != "0" {
+			t.Errorf("since = %q, want %q", q.Get("since"), "0")
+		}
+		befores = append(befores, q.Get("before"))
+
+		// 1005 actions since the cursor: a full page, then the 5 oldest
+		if q.Get("before") == "" {
+			return http.StatusOK, actionPage(1005, actionsPageSize)
+		}
+		return http.StatusOK, actionPage(5, 5)
+	})
+	b := &board{client: c, ID: "b"}
+
+	actions, err := b.ActionsSince("0", ActionsOldestFirst())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(befores) != 2 || befores[1] != "6" {
+		t.Errorf("requests used before = %q, want [\"\" \"6\"]", befores)
+	}
+	if len(actions) != 1005 {
+		t.Fatalf("got %d actions, want 1005", len(actions))
+	}
+	if actions[0].ID != "1" || actions[len(actions)-1].ID != "1005" {
+		t.Errorf("actions run from %s to %s, want 1 to 1005",
+			actions[0].ID, actions[len(actions)-1].ID)
+	}
+}
@@ -0,0 +1,39 @@
+package trello
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+const authorizeURL = "https://trello.com/1/authorize"
+
+// AuthorizeURL returns the page a user visits to grant appName a token
+// for the application key. scopes are any of "read", "write" and
+// "account", defaulting to "read" when empty; expiration is a value such
+// as "1hour", "1day", "30days" or "never", and may be empty to use
+// Trello's default.
+func AuthorizeURL(key, appName string, scopes []string, expiration string) (string, error) {
+	if len(scopes) == 0 {
+		scopes = []string{"read"}
+	}
+	for _, scope := range scopes {
+		switch scope {
+		case "read", "write", "account":
+		default:
+			return "", errors.New("invalid authorization scope: " + scope)
+		}
+	}
+
+	params := url.Values{
+		"key":           {key},
+		"name":          {appName},
+		"scope":         {strings.Join(scopes, ",")},
+		"response_type": {"token"},
+	}
+	if len(expiration) > 0 {
+		params.Set("expiration", expiration)
+	}
+
+	return authorizeURL + "?" + params.Encode(), nil
+}
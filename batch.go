@@ -0,0 +1,56 @@
+package trello
+
+import "sync"
+
+// BatchOption configures calls that work on many items at once.
+type BatchOption func(*batchOptions)
+
+type batchOptions struct {
+	concurrency int
+}
+
+// Concurrency bounds how many requests a batch call has in flight at
+// once. Values below 1 are treated as 1.
+func Concurrency(n int) BatchOption {
+	return func(o *batchOptions) {
+		o.concurrency = n
+	}
+}
+
+func newBatchOptions(defaultConcurrency int, opts []BatchOption) batchOptions {
+	o := batchOptions{
+		concurrency: defaultConcurrency,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.concurrency < 1 {
+		o.concurrency = 1
+	}
+	return o
+}
+
+// run calls fn for each index in [0, n) using at most o.concurrency
+// goroutines, and returns the error fn returned for each index.
+func (o batchOptions) run(n int, fn func(i int) error) []error {
+	errs := make([]error, n)
+
+	idx := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < o.concurrency && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range idx {
+				errs[i] = fn(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		idx <- i
+	}
+	close(idx)
+	wg.Wait()
+
+	return errs
+}
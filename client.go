@@ -1,53 +1,283 @@
 package trello
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
+	"sort"
+	"strconv"
+	"time"
 )
 
 type Client interface {
 	BoardService() BoardService
 	ListService() ListService
+
+	// WithCallTimeout returns a copy of the client whose requests are each
+	// cancelled if they take longer than d. A zero d disables the timeout.
+	WithCallTimeout(d time.Duration) Client
+
+	// Get and Put are low-level escape hatches for endpoints this package
+	// doesn't wrap yet. path is relative to the API root, such as
+	// "/1/cards/{id}"; the client's key and token are added to params and
+	// the JSON response is decoded into out when out is non-nil.
+	Get(path string, params url.Values, out interface{}) error
+	Put(path string, params url.Values, out interface{}) error
 }
 
 type BoardService interface {
 	GetBoard(id string) (Board, error)
+
+	// GetBoardSummary fetches only the board's id, name, closed state,
+	// short URL and short link, for callers such as board pickers that
+	// need no more.
+	GetBoardSummary(id string) (Board, error)
+
+	// GetBoards fetches the boards concurrently, four at a time unless
+	// set with Concurrency, and returns them in the order of ids. If any
+	// fetch fails the boards that were fetched are still returned, with
+	// nil in place of the others, along with the joined errors.
+	GetBoards(ids []string, opts ...BatchOption) ([]Board, error)
+
+	Delete(id string) error
+
+	// DeleteBoardConfirm deletes the board only if its name matches
+	// expectedName, returning a *BoardNameMismatchError otherwise.
+	DeleteBoardConfirm(id, expectedName string) error
+
+	// CreateFromTemplate creates a board named name from the template
+	// board templateID, keeping the template's cards.
+	CreateFromTemplate(templateID, name string) (Board, error)
+
+	// Import creates a board named name with the open lists and cards of
+	// data, as returned by Board.Export, in their original order. Ids,
+	// members and history aren't restored. If some items fail, the new
+	// board is returned with an *ImportError listing them.
+	Import(data []byte, name string) (Board, error)
 }
 
 type ListService interface {
+	GetList(id string) (List, error)
+
+	// Create adds a list to the board. pos is "top", "bottom", a positive
+	// number (see FormatPosition) or empty for Trello's default.
 	Create(name, boardID, pos string) (List, error)
+
+	// EnsureList returns the board's first open list named name,
+	// creating it at pos if the board has no such open list. Archived
+	// lists with the same name are ignored.
+	EnsureList(boardID, name, pos string) (List, error)
 }
 
 type Board interface {
 	GetID() string
 	Name() string
-	Lists() ([]List, error)
+	ShortURL() string
+	ShortLink() string
+	Lists(opts ...ListsOption) ([]List, error)
+	ListCount() (int, error)
+
+	// ListsByState fetches all of the board's lists in one call and splits
+	// them into open and archived lists.
+	ListsByState() (open, closed []List, err error)
+
+	// ChangedListsSince returns the lists created or updated by board
+	// actions after actionID, each once and in the board's order.
+	ChangedListsSince(actionID string) ([]List, error)
+
+	// SetLabelName renames the board's label of the given color.
+	SetLabelName(color Color, name string) error
+	PluginData() ([]PluginData, error)
+
+	// CreateWebhook registers a webhook that calls callbackURL whenever
+	// the board changes.
+	CreateWebhook(callbackURL, desc string) (Webhook, error)
+
+	// Actions returns the board's actions, paging through all of them,
+	// newest first unless ActionsOldestFirst is given. Limit the window
+	// with ActionsSinceTime and ActionsBeforeTime to keep the fetch small.
+	Actions(opts ...ActionsOption) ([]Action, error)
+
+	// ActionsSince returns every board action after the action with id
+	// actionID, for syncing from a saved cursor.
+	ActionsSince(actionID string, opts ...ActionsOption) ([]Action, error)
+
+	// LabelUsage returns how many of the board's open cards carry each of
+	// its labels, keyed by label id. Unused labels map to zero.
+	LabelUsage() (map[string]int, error)
+
+	// IsStarred reports whether the authenticated member has starred the
+	// board. The member's stars are cached for a minute, so checking many
+	// boards costs a single request.
+	IsStarred() (bool, error)
+
+	// PowerUps returns the power-ups enabled on the board.
+	PowerUps() ([]PowerUp, error)
+
+	// Export returns the board's JSON with its lists, cards, checklists,
+	// members and actions nested in it, as a backup. The whole response
+	// is held in memory, which can be many megabytes for large boards,
+	// and Trello caps how many actions a single request returns.
+	Export() ([]byte, error)
+
+	// SetPermissionLevel sets who can see the board: "org", "private" or
+	// "public".
+	SetPermissionLevel(level string) error
+
+	// SetBackground sets the board's background to a preset color name
+	// such as "blue" or to the id of an uploaded background.
+	SetBackground(background string) error
+
+	// Update applies every non-nil field of changes in a single request.
+	Update(changes BoardUpdate) error
+
+	// ArchiveAllCards archives the cards on every open list of the board,
+	// one list at a time unless set with Concurrency. It carries on past
+	// lists that fail and returns their errors joined.
+	ArchiveAllCards(opts ...BatchOption) error
+
+	// Raw returns every top-level field of the board as Trello sent it,
+	// for fields the typed accessors don't cover yet. It is populated for
+	// boards fetched by id, such as with BoardService.GetBoard, and nil
+	// for boards returned when creating one.
+	Raw() map[string]json.RawMessage
+
+	Prefs() BoardPrefs
+	IsTemplate() bool
+
+	// Refresh refetches the board and replaces its local state.
+	Refresh() error
+	RefreshContext(ctx context.Context) error
+	CardAging() string
+
+	// VotingPermission and CommentPermission report who may vote on and
+	// comment on the board's cards, such as "members" or "disabled".
+	VotingPermission() string
+	CommentPermission() string
+
+	// BackgroundImageURL returns the URL of the smallest scaled background
+	// image at least minWidth wide, or of the largest one if none is that
+	// wide. It returns "" for solid-color backgrounds.
+	BackgroundImageURL(minWidth int) string
 }
 
 type List interface {
 	Name() string
 	GetID() string
+	Position() float64
+	IsClosed() bool
+	BoardID() string
+
+	// Board fetches the board the list belongs to.
+	Board() (Board, error)
+
+	// CardCount returns the number of open cards on the list, fetching
+	// only their ids.
+	CardCount() (int, error)
+
+	// Refresh refetches the list and replaces its local state.
+	Refresh() error
+	RefreshContext(ctx context.Context) error
+
 	Rename(newName string) error
+	Archive() error
+	Unarchive() error
+
+	// CreateWebhook registers a webhook that calls callbackURL whenever
+	// the list changes.
+	CreateWebhook(callbackURL, desc string) (Webhook, error)
+
+	// ArchiveAllCards archives every card on the list.
+	ArchiveAllCards() error
+
+	// Delete always fails with ErrListDeleteUnsupported: Trello's API
+	// can't delete lists, only archive them with Archive.
+	Delete() error
+
+	// Deprecated: Close archives the list; use Archive instead.
 	Close() error
 }
 
 type client struct {
 	key   string
 	token string
+
+	httpClient  *http.Client
+	callTimeout time.Duration
+	dryRun      func(*http.Request)
+	retryPolicy RetryPolicy
+	middleware  []Middleware
+	boardStars  *boardStarCache
 }
 
 type boardService struct {
 	client *client
 }
 
-func NewClient(key, token string) Client {
-	return &client{
-		key:   key,
-		token: token,
+// ClientOption configures optional behaviour of a Client.
+type ClientOption func(*client)
+
+// WithDryRun makes the client hand every request it builds to fn instead
+// of sending it; the call then returns ErrDryRun.
+func WithDryRun(fn func(*http.Request)) ClientOption {
+	return func(c *client) {
+		c.dryRun = fn
+	}
+}
+
+// WithHTTPClient makes the client send its requests with hc instead of
+// http.DefaultClient.
+func WithHTTPClient(hc *http.Client) ClientOption {
+	return func(c *client) {
+		c.httpClient = hc
+	}
+}
+
+// DefaultTransport returns a transport suited to heavy concurrent use of
+// the Trello API. Every request goes to the same host, and
+// http.DefaultTransport keeps only two idle connections per host, so
+// concurrent callers sharing it keep opening and tearing down
+// connections. Use it with WithHTTPClient:
+//
+//	hc := &http.Client{Transport: trello.DefaultTransport()}
+//	c := trello.NewClient(key, token, trello.WithHTTPClient(hc))
+func DefaultTransport() *http.Transport {
+	var t *http.Transport
+	if dt, ok := http.DefaultTransport.(*http.Transport); ok {
+		t = dt.Clone()
+	} else {
+		// http.DefaultTransport has been replaced, e.g. by a test mock
+		t = &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			ForceAttemptHTTP2:     true,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+		}
+	}
+	t.MaxIdleConns = 100
+	t.MaxIdleConnsPerHost = 32
+	return t
+}
+
+func NewClient(key, token string, opts ...ClientOption) Client {
+	c := &client{
+		key:        key,
+		token:      token,
+		boardStars: &boardStarCache{},
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
+	return c
 }
 
 func (c *client) BoardService() BoardService {
@@ -62,55 +292,149 @@ func (c *client) ListService() ListService {
 	}
 }
 
-const baseURL = "https://api.trello.com"
+func (c *client) WithCallTimeout(d time.Duration) Client {
+	cc := *c
+	cc.callTimeout = d
+	return &cc
+}
+
+func (c *client) Get(path string, params url.Values, out interface{}) error {
+	return c.do("GET", path, params, out)
+}
+
+func (c *client) Put(path string, params url.Values, out interface{}) error {
+	return c.do("PUT", path, params, out)
+}
+
+// boardFields is Trello's default board field set plus the fields our
+// accessors need that Trello doesn't send unless asked: powerUps for
+// CardAging and shortLink for ShortLink.
+const boardFields = "name,desc,descData,closed,idOrganization,pinned," +
+	"url,shortUrl,prefs,labelNames,powerUps,shortLink"
 
 func (b *boardService) GetBoard(id string) (Board, error) {
-	restURL := fmt.Sprintf("%s/1/boards/%s?key=%s", baseURL, id, b.client.key)
-	if len(b.client.token) > 0 {
-		restURL += fmt.Sprintf("&token=%s", b.client.token)
+	d, err := b.client.getBoard(context.Background(), id, nil)
+	if err != nil {
+		return nil, err
 	}
+	return d, nil
+}
 
-	// TODO(ttacon)
-	req, err := http.NewRequest(
-		"GET",
-		restURL,
-		nil,
-	)
+func (b *boardService) GetBoardSummary(id string) (Board, error) {
+	d, err := b.client.getBoard(context.Background(), id,
+		url.Values{"fields": {"id,name,closed,shortUrl,shortLink"}})
 	if err != nil {
 		return nil, err
 	}
+	return d, nil
+}
 
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
+func (b *boardService) GetBoards(ids []string, opts ...BatchOption) ([]Board, error) {
+	boards := make([]Board, len(ids))
+	errs := newBatchOptions(4, opts).run(len(ids), func(i int) error {
+		d, err := b.client.getBoard(context.Background(), ids[i], nil)
+		if err != nil {
+			return fmt.Errorf("board %s: %w", ids[i], err)
+		}
+		boards[i] = d
+		return nil
+	})
+
+	return boards, errors.Join(errs...)
+}
+
+func (c *client) getBoard(ctx context.Context, id string, params url.Values) (*board, error) {
+	if len(params.Get("fields")) == 0 {
+		if params == nil {
+			params = url.Values{}
+		}
+		params.Set("fields", boardFields)
+	}
+
+	var data json.RawMessage
+	if err := c.doContext(ctx, "GET", "/1/boards/"+id, params, &data); err != nil {
 		return nil, err
 	}
 
 	var d board
-	if err := json.NewDecoder(resp.Body).Decode(&d); err != nil {
-		resp.Body.Close()
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &d.raw); err != nil {
 		return nil, err
 	}
-	resp.Body.Close()
 
-	d.client = b.client
+	d.client = c
+
+	return &d, nil
+}
+
+func (b *boardService) Delete(id string) error {
+	return b.client.do("DELETE", "/1/boards/"+id, nil, nil)
+}
+
+// BoardNameMismatchError is returned by DeleteBoardConfirm when the
+// board's name isn't the one the caller expected to delete.
+type BoardNameMismatchError struct {
+	ID       string
+	Expected string
+	Actual   string
+}
+
+func (e *BoardNameMismatchError) Error() string {
+	return fmt.Sprintf("board %s is named %q, not %q; refusing to delete",
+		e.ID, e.Actual, e.Expected)
+}
+
+func (b *boardService) DeleteBoardConfirm(id, expectedName string) error {
+	bb, err := b.GetBoard(id)
+	if err != nil {
+		return err
+	}
+	if bb.Name() != expectedName {
+		return &BoardNameMismatchError{
+			ID:       id,
+			Expected: expectedName,
+			Actual:   bb.Name(),
+		}
+	}
+	return b.Delete(id)
+}
+
+func (b *boardService) CreateFromTemplate(templateID, name string) (Board, error) {
+	body := map[string]string{
+		"name":           name,
+		"idBoardSource":  templateID,
+		"keepFromSource": "cards",
+	}
+
+	var d = board{
+		client: b.client,
+	}
+	if err := b.client.doJSON(context.Background(), "POST", "/1/boards", body, &d); err != nil {
+		return nil, err
+	}
 
 	return &d, nil
 }
 
 type board struct {
-	client *client `json:"-"`
+	client *client                    `json:"-"`
+	raw    map[string]json.RawMessage `json:"-"`
 
 	ID             string                 `json:"id"`
 	DescData       interface{}            `json:"descData"` // TODO(ttacon): identify the actual type
 	Closed         bool                   `json:"closed"`
 	IDOrganization interface{}            `json:"idOrganization"` // same as descData
 	Pinned         bool                   `json:"pinned"`
-	ShortURL       string                 `json:"shortUrl"`
+	BoardShortURL  string                 `json:"shortUrl"`
+	BoardShortLink string                 `json:"shortLink"`
 	Desc           string                 `json:"desc"`
 	BoardName      string                 `json:"name"`
 	URL            string                 `json:"url"`
-	Prefs          map[string]interface{} `json:"prefs"`      // TODO(ttacon): pull concrete struct out
-	LabelNames     map[string]interface{} `json:"labelNames"` // same as prefs
+	BoardPrefs     BoardPrefs             `json:"prefs"`
+	LabelNames     map[string]interface{} `json:"labelNames"` // TODO(ttacon): pull concrete struct out
+	BoardPowerUps  []string               `json:"powerUps"`
 
 	// optional fields
 	BoardLists []*list `json:"lists"`
@@ -124,37 +448,153 @@ func (b *board) Name() string {
 	return b.BoardName
 }
 
-func (b *board) Lists() ([]List, error) {
-	restURL := fmt.Sprintf("%s/1/boards/%s?key=%s&lists=all", baseURL, b.ID, b.client.key)
-	if len(b.client.token) > 0 {
-		restURL += fmt.Sprintf("&token=%s", b.client.token)
+func (b *board) ShortURL() string {
+	return b.BoardShortURL
+}
+
+func (b *board) ShortLink() string {
+	return b.BoardShortLink
+}
+
+// BoardPrefs holds a board's preferences.
+type BoardPrefs struct {
+	PermissionLevel string `json:"permissionLevel"`
+	Voting          string `json:"voting"`
+	Comments        string `json:"comments"`
+	Invitations     string `json:"invitations"`
+	SelfJoin        bool   `json:"selfJoin"`
+	CardCovers      bool   `json:"cardCovers"`
+	IsTemplate      bool   `json:"isTemplate"`
+
+	// CardAging is "regular" or "pirate" when the card aging power-up
+	// is enabled; Trello may still report it when the power-up is off.
+	CardAging string `json:"cardAging"`
+
+	Background      string `json:"background"`
+	BackgroundColor string `json:"backgroundColor"`
+	BackgroundImage string `json:"backgroundImage"`
+
+	// BackgroundImageScaled holds scaled copies of the background image;
+	// it is empty for solid-color backgrounds.
+	BackgroundImageScaled []ScaledImage `json:"backgroundImageScaled"`
+}
+
+// ScaledImage is one size of an image Trello has scaled.
+type ScaledImage struct {
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	URL    string `json:"url"`
+}
+
+func (b *board) Prefs() BoardPrefs {
+	return b.BoardPrefs
+}
+
+func (b *board) IsTemplate() bool {
+	return b.BoardPrefs.IsTemplate
+}
+
+// CardAging returns the board's card aging mode, or "" if the card aging
+// power-up isn't enabled.
+func (b *board) CardAging() string {
+	if !b.cardAgingEnabled() {
+		return ""
 	}
+	return b.BoardPrefs.CardAging
+}
 
-	// TODO(ttacon)
-	req, err := http.NewRequest(
-		"GET",
-		restURL,
-		nil,
-	)
+func (b *board) VotingPermission() string {
+	return b.BoardPrefs.Voting
+}
+
+func (b *board) CommentPermission() string {
+	return b.BoardPrefs.Comments
+}
+
+func (b *board) BackgroundImageURL(minWidth int) string {
+	var best *ScaledImage
+	for i := range b.BoardPrefs.BackgroundImageScaled {
+		img := &b.BoardPrefs.BackgroundImageScaled[i]
+		switch {
+		case best == nil:
+			best = img
+		case best.Width < minWidth:
+			// anything larger gets closer to minWidth
+			if img.Width > best.Width {
+				best = img
+			}
+		case img.Width >= minWidth && img.Width < best.Width:
+			best = img
+		}
+	}
+
+	if best == nil {
+		return ""
+	}
+	return best.URL
+}
+
+func (b *board) cardAgingEnabled() bool {
+	for _, p := range b.BoardPowerUps {
+		if p == "cardAging" {
+			return true
+		}
+	}
+	return false
+}
+
+// ListsOption configures how Board.Lists orders the lists it returns.
+type ListsOption func(*listsOptions)
+
+type listsOptions struct {
+	sortByPosition bool
+}
+
+// SortListsByPosition sorts the lists returned by Board.Lists by their
+// position on the board. Without it lists are returned in API order.
+func SortListsByPosition() ListsOption {
+	return func(o *listsOptions) {
+		o.sortByPosition = true
+	}
+}
+
+func (b *board) Refresh() error {
+	return b.RefreshContext(context.Background())
+}
+
+func (b *board) RefreshContext(ctx context.Context) error {
+	d, err := b.client.getBoard(ctx, b.ID, nil)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	*b = *d
+	return nil
+}
+
+func (b *board) Raw() map[string]json.RawMessage {
+	return b.raw
+}
+
+func (b *board) Lists(opts ...ListsOption) ([]List, error) {
+	var o listsOptions
+	for _, opt := range opts {
+		opt(&o)
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	boardLists, err := b.fetchLists(nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var d board
-	if err := json.NewDecoder(resp.Body).Decode(&d); err != nil {
-		resp.Body.Close()
-		return nil, err
+	if o.sortByPosition {
+		sort.SliceStable(boardLists, func(i, j int) bool {
+			return boardLists[i].Pos < boardLists[j].Pos
+		})
 	}
-	resp.Body.Close()
 
 	// ugh, type rules...
-	ls := make([]List, len(d.BoardLists))
-	for i, list := range d.BoardLists {
+	ls := make([]List, len(boardLists))
+	for i, list := range boardLists {
 		list.client = b.client
 		ls[i] = list
 	}
@@ -162,112 +602,502 @@ func (b *board) Lists() ([]List, error) {
 	return ls, nil
 }
 
-type list struct {
-	client *client `json:"-"`
+// ListCount returns the number of lists on the board, fetching only
+// their ids rather than the full list objects.
+func (b *board) ListCount() (int, error) {
+	boardLists, err := b.fetchLists(url.Values{"list_fields": {"id"}})
+	if err != nil {
+		return 0, err
+	}
+	return len(boardLists), nil
+}
 
-	ID       string `json:"id"`
-	ListName string `json:"name"`
+func (b *board) ListsByState() (open, closed []List, err error) {
+	boardLists, err := b.fetchLists(nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, list := range boardLists {
+		list.client = b.client
+		if list.Closed {
+			closed = append(closed, list)
+		} else {
+			open = append(open, list)
+		}
+	}
+
+	return open, closed, nil
 }
 
-func (l *list) Name() string {
-	// TODO(ttacon)
-	return l.ListName
+func (b *board) ChangedListsSince(actionID string) ([]List, error) {
+	actions, err := b.fetchActions(url.Values{
+		"filter": {"createList,updateList"},
+		"since":  {actionID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(actions) == 0 {
+		return nil, nil
+	}
+
+	changed := make(map[string]bool)
+	for _, a := range actions {
+		var data struct {
+			List struct {
+				ID string `json:"id"`
+			} `json:"list"`
+		}
+		if err := json.Unmarshal(a.Data, &data); err != nil {
+			return nil, err
+		}
+		changed[data.List.ID] = true
+	}
+
+	boardLists, err := b.fetchLists(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ls []List
+	for _, list := range boardLists {
+		if changed[list.ID] {
+			list.client = b.client
+			ls = append(ls, list)
+		}
+	}
+
+	return ls, nil
 }
 
-func (l *list) GetID() string {
-	return l.ID
+// fetchLists retrieves every list on the board, adding params to the
+// board request.
+func (b *board) fetchLists(params url.Values) ([]*list, error) {
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("lists", "all")
+
+	var d board
+	if err := b.client.do("GET", "/1/boards/"+b.ID, params, &d); err != nil {
+		return nil, err
+	}
+
+	return d.BoardLists, nil
 }
 
-func (l *list) Rename(newName string) error {
-	restURL := fmt.Sprintf("%s/1/lists/%s/name?key=%s&value=%s",
-		baseURL, l.ID, l.client.key, url.QueryEscape(newName))
-	if len(l.client.token) > 0 {
-		restURL += fmt.Sprintf("&token=%s", l.client.token)
+func (b *board) SetLabelName(color Color, name string) error {
+	if !color.Valid() {
+		return errors.New("invalid label color: " + string(color))
 	}
 
-	req, err := http.NewRequest(
-		"PUT",
-		restURL,
-		nil,
-	)
+	err := b.client.do("PUT", "/1/boards/"+b.ID+"/labelNames/"+string(color),
+		url.Values{"value": {name}}, nil)
 	if err != nil {
 		return err
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	if b.LabelNames == nil {
+		b.LabelNames = make(map[string]interface{})
+	}
+	b.LabelNames[string(color)] = name
+	return nil
+}
+
+func validPermissionLevel(level string) error {
+	switch level {
+	case "org", "private", "public":
+		return nil
+	}
+	return errors.New("invalid permission level: " + level)
+}
+
+func (b *board) SetPermissionLevel(level string) error {
+	if err := validPermissionLevel(level); err != nil {
+		return err
+	}
+
+	err := b.client.do("PUT", "/1/boards/"+b.ID+"/prefs/permissionLevel",
+		url.Values{"value": {level}}, nil)
 	if err != nil {
 		return err
-	} else if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return errors.New("bad response code: " + resp.Status)
 	}
 
+	b.BoardPrefs.PermissionLevel = level
 	return nil
 }
 
-func (l *list) Close() error {
-	restURL := fmt.Sprintf("%s/1/lists/%s/name?key=%s&value=true",
-		baseURL, l.ID, l.client.key)
-	if len(l.client.token) > 0 {
-		restURL += fmt.Sprintf("&token=%s", l.client.token)
+func (b *board) SetBackground(background string) error {
+	err := b.client.do("PUT", "/1/boards/"+b.ID+"/prefs/background",
+		url.Values{"value": {background}}, nil)
+	if err != nil {
+		return err
 	}
 
-	req, err := http.NewRequest(
-		"PUT",
-		restURL,
-		nil,
-	)
+	b.BoardPrefs.Background = background
+	return nil
+}
+
+func (b *board) CreateWebhook(callbackURL, desc string) (Webhook, error) {
+	return b.client.createWebhook(b.ID, callbackURL, desc)
+}
+
+func (b *board) ArchiveAllCards(opts ...BatchOption) error {
+	open, _, err := b.ListsByState()
 	if err != nil {
 		return err
 	}
 
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
+	errs := newBatchOptions(1, opts).run(len(open), func(i int) error {
+		if err := open[i].ArchiveAllCards(); err != nil {
+			return fmt.Errorf("list %s: %w", open[i].GetID(), err)
+		}
+		return nil
+	})
+
+	return errors.Join(errs...)
+}
+
+// BoardUpdate holds changes for Board.Update; nil fields are left as
+// they are.
+type BoardUpdate struct {
+	Name   *string
+	Desc   *string
+	Closed *bool
+
+	PermissionLevel *string
+	Background      *string
+	SelfJoin        *bool
+	CardCovers      *bool
+}
+
+func (b *board) Update(changes BoardUpdate) error {
+	params := url.Values{}
+	if changes.Name != nil {
+		params.Set("name", *changes.Name)
+	}
+	if changes.Desc != nil {
+		params.Set("desc", *changes.Desc)
+	}
+	if changes.Closed != nil {
+		params.Set("closed", strconv.FormatBool(*changes.Closed))
+	}
+	if changes.PermissionLevel != nil {
+		if err := validPermissionLevel(*changes.PermissionLevel); err != nil {
+			return err
+		}
+		params.Set("prefs/permissionLevel", *changes.PermissionLevel)
+	}
+	if changes.Background != nil {
+		params.Set("prefs/background", *changes.Background)
+	}
+	if changes.SelfJoin != nil {
+		params.Set("prefs/selfJoin", strconv.FormatBool(*changes.SelfJoin))
+	}
+	if changes.CardCovers != nil {
+		params.Set("prefs/cardCovers", strconv.FormatBool(*changes.CardCovers))
+	}
+	if len(params) == 0 {
+		return nil
+	}
+
+	if err := b.client.do("PUT", "/1/boards/"+b.ID, params, nil); err != nil {
 		return err
-	} else if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return errors.New("bad response code: " + resp.Status)
 	}
 
+	if changes.Name != nil {
+		b.BoardName = *changes.Name
+	}
+	if changes.Desc != nil {
+		b.Desc = *changes.Desc
+	}
+	if changes.Closed != nil {
+		b.Closed = *changes.Closed
+	}
+	if changes.PermissionLevel != nil {
+		b.BoardPrefs.PermissionLevel = *changes.PermissionLevel
+	}
+	if changes.Background != nil {
+		b.BoardPrefs.Background = *changes.Background
+	}
+	if changes.SelfJoin != nil {
+		b.BoardPrefs.SelfJoin = *changes.SelfJoin
+	}
+	if changes.CardCovers != nil {
+		b.BoardPrefs.CardCovers = *changes.CardCovers
+	}
 	return nil
 }
 
-type listService struct {
-	client *client
+// PluginData is data a power-up has stored on a board or card.
+type PluginData struct {
+	ID       string `json:"id"`
+	IDPlugin string `json:"idPlugin"`
+	Scope    string `json:"scope"`
+	IDModel  string `json:"idModel"`
+
+	// Value is the JSON the power-up stored; its shape is up to the
+	// power-up.
+	Value json.RawMessage `json:"-"`
 }
 
-func (l *listService) Create(name, boardID, pos string) (List, error) {
-	restURL := fmt.Sprintf("%s/1/lists?key=%s&name=%s&idBoard=%s",
-		baseURL, l.client.key, url.QueryEscape(name), url.QueryEscape(boardID))
-	if len(pos) > 0 {
-		restURL += fmt.Sprintf("&pos=%s", pos)
+func (b *board) PluginData() ([]PluginData, error) {
+	// Trello sends the stored value as a JSON-encoded string.
+	var d []struct {
+		PluginData
+		RawValue string `json:"value"`
+	}
+	if err := b.client.do("GET", "/1/boards/"+b.ID+"/pluginData", nil, &d); err != nil {
+		return nil, err
+	}
+
+	pds := make([]PluginData, len(d))
+	for i, pd := range d {
+		pds[i] = pd.PluginData
+		pds[i].Value = json.RawMessage(pd.RawValue)
 	}
-	if len(l.client.token) > 0 {
-		restURL += fmt.Sprintf("&token=%s", l.client.token)
+
+	return pds, nil
+}
+
+func (b *board) Export() ([]byte, error) {
+	params := url.Values{
+		"lists":         {"all"},
+		"cards":         {"all"},
+		"checklists":    {"all"},
+		"members":       {"all"},
+		"actions":       {"all"},
+		"actions_limit": {"1000"},
 	}
 
-	req, err := http.NewRequest(
-		"POST",
-		restURL,
-		nil,
-	)
+	var data json.RawMessage
+	if err := b.client.do("GET", "/1/boards/"+b.ID, params, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (b *board) LabelUsage() (map[string]int, error) {
+	var labels []struct {
+		ID string `json:"id"`
+	}
+	err := b.client.do("GET", "/1/boards/"+b.ID+"/labels",
+		url.Values{"fields": {"id"}, "limit": {"1000"}}, &labels)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	var cards []struct {
+		IDLabels []string `json:"idLabels"`
+	}
+	err = b.client.do("GET", "/1/boards/"+b.ID+"/cards",
+		url.Values{"fields": {"idLabels"}}, &cards)
 	if err != nil {
 		return nil, err
-	} else if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return nil, errors.New("bad response code: " + resp.Status)
+	}
+
+	usage := make(map[string]int, len(labels))
+	for _, l := range labels {
+		usage[l.ID] = 0
+	}
+	for _, c := range cards {
+		for _, id := range c.IDLabels {
+			usage[id]++
+		}
+	}
+
+	return usage, nil
+}
+
+func (b *board) IsStarred() (bool, error) {
+	return b.client.isBoardStarred(b.ID)
+}
+
+// PowerUp is a power-up (plugin) that can be enabled on boards.
+type PowerUp struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (b *board) PowerUps() ([]PowerUp, error) {
+	var pus []PowerUp
+	err := b.client.do("GET", "/1/boards/"+b.ID+"/plugins",
+		url.Values{"filter": {"enabled"}}, &pus)
+	if err != nil {
+		return nil, err
+	}
+	return pus, nil
+}
+
+// ErrListDeleteUnsupported is returned by List.Delete.
+var ErrListDeleteUnsupported = errors.New("trello: lists can't be deleted through the API, use Archive instead")
+
+type list struct {
+	client *client `json:"-"`
+
+	ID       string  `json:"id"`
+	ListName string  `json:"name"`
+	Pos      float64 `json:"pos"`
+	Closed   bool    `json:"closed"`
+	IDBoard  string  `json:"idBoard"`
+}
+
+func (l *list) Name() string {
+	// TODO(ttacon)
+	return l.ListName
+}
+
+func (l *list) GetID() string {
+	return l.ID
+}
+
+func (l *list) Position() float64 {
+	return l.Pos
+}
+
+func (l *list) IsClosed() bool {
+	return l.Closed
+}
+
+func (l *list) BoardID() string {
+	return l.IDBoard
+}
+
+func (l *list) Board() (Board, error) {
+	d, err := l.client.getBoard(context.Background(), l.IDBoard, nil)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (l *list) CardCount() (int, error) {
+	var cards []struct {
+		ID string `json:"id"`
+	}
+	err := l.client.do("GET", "/1/lists/"+l.ID+"/cards",
+		url.Values{"fields": {"id"}}, &cards)
+	if err != nil {
+		return 0, err
+	}
+	return len(cards), nil
+}
+
+func (l *list) Refresh() error {
+	return l.RefreshContext(context.Background())
+}
+
+func (l *list) RefreshContext(ctx context.Context) error {
+	d, err := l.client.getList(ctx, l.ID)
+	if err != nil {
+		return err
+	}
+	*l = *d
+	return nil
+}
+
+func (l *list) Rename(newName string) error {
+	return l.client.do("PUT", "/1/lists/"+l.ID+"/name",
+		url.Values{"value": {newName}}, nil)
+}
+
+func (l *list) CreateWebhook(callbackURL, desc string) (Webhook, error) {
+	return l.client.createWebhook(l.ID, callbackURL, desc)
+}
+
+func (l *list) Archive() error {
+	return l.setClosed(true)
+}
+
+func (l *list) Unarchive() error {
+	return l.setClosed(false)
+}
+
+func (l *list) Close() error {
+	return l.Archive()
+}
+
+func (l *list) ArchiveAllCards() error {
+	return l.client.do("POST", "/1/lists/"+l.ID+"/archiveAllCards", nil, nil)
+}
+
+func (l *list) Delete() error {
+	return ErrListDeleteUnsupported
+}
+
+// setClosed archives or unarchives the list, replacing the local state
+// with the updated list Trello returns.
+func (l *list) setClosed(closed bool) error {
+	var d = list{
+		client: l.client,
+	}
+	err := l.client.do("PUT", "/1/lists/"+l.ID+"/closed",
+		url.Values{"value": {strconv.FormatBool(closed)}}, &d)
+	if err != nil {
+		return err
+	}
+	*l = d
+	return nil
+}
+
+type listService struct {
+	client *client
+}
+
+func (l *listService) GetList(id string) (List, error) {
+	d, err := l.client.getList(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (c *client) getList(ctx context.Context, id string) (*list, error) {
+	var d = list{
+		client: c,
+	}
+	if err := c.doContext(ctx, "GET", "/1/lists/"+id, nil, &d); err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func (l *listService) Create(name, boardID, pos string) (List, error) {
+	params := url.Values{
+		"name":    {name},
+		"idBoard": {boardID},
+	}
+	if len(pos) > 0 {
+		params.Set("pos", pos)
 	}
 
 	var ll = list{
 		client: l.client,
 	}
-	if err = json.NewDecoder(resp.Body).Decode(&ll); err != nil {
-		resp.Body.Close()
+	if err := l.client.do("POST", "/1/lists", params, &ll); err != nil {
 		return nil, err
 	}
-	resp.Body.Close()
 
 	return &ll, nil
 }
+
+func (l *listService) EnsureList(boardID, name, pos string) (List, error) {
+	// only open lists count, or cards would land in an archived list
+	var lists []*list
+	err := l.client.do("GET", "/1/boards/"+boardID+"/lists",
+		url.Values{"filter": {"open"}}, &lists)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ll := range lists {
+		if ll.ListName == name {
+			ll.client = l.client
+			return ll, nil
+		}
+	}
+
+	return l.Create(name, boardID, pos)
+}
@@ -0,0 +1,68 @@
+package trello
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBoardCardAging(t *testing.T) {
+	tests := []struct {
+		powerUps []string
+		want     string
+	}{
+		{[]string{"cardAging"}, "pirate"},
+		{[]string{"voting"}, ""},
+		{nil, ""},
+	}
+
+	for _, tt := range tests {
+		b := board{
+			BoardPrefs:    BoardPrefs{CardAging: "pirate"},
+			BoardPowerUps: tt.powerUps,
+		}
+		if got := b.CardAging(); got != tt.want {
+			t.Errorf("CardAging() with powerUps %v = %q, want %q", tt.powerUps, got, tt.want)
+		}
+	}
+}
+
+func TestGetBoardRequestedFields(t *testing.T) {
+	var fields string
+	c := NewClient("key", "token", WithDryRun(func(r *http.Request) {
+		fields = r.URL.Query().Get("fields")
+	}))
+
+	c.BoardService().GetBoard("abc")
+	for _, want := range []string{"powerUps", "shortLink"} {
+		if !strings.Contains(fields, want) {
+			t.Errorf("GetBoard requested fields %q, want %s among them", fields, want)
+		}
+	}
+}
+
+func TestEnsureListOnlyMatchesOpenLists(t *testing.T) {
+	var req *http.Request
+	c := NewClient("key", "token", WithDryRun(func(r *http.Request) {
+		req = r
+	}))
+
+	c.ListService().EnsureList("b", "Todo", "")
+	if req == nil {
+		t.Fatal("EnsureList sent no request")
+	}
+	if req.URL.Path != "/1/boards/b/lists" || req.URL.Query().Get("filter") != "open" {
+		t.Errorf("EnsureList looked up %s, want /1/boards/b/lists?filter=open", req.URL)
+	}
+}
+
+func TestDefaultTransportWithReplacedDefault(t *testing.T) {
+	orig := http.DefaultTransport
+	defer func() { http.DefaultTransport = orig }()
+	http.DefaultTransport = http.NewFileTransport(http.Dir("."))
+
+	tr := DefaultTransport()
+	if tr.MaxIdleConnsPerHost != 32 {
+		t.Errorf("MaxIdleConnsPerHost = %d, want 32", tr.MaxIdleConnsPerHost)
+	}
+}
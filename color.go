@@ -0,0 +1,37 @@
+package trello
+
+// Color is one of the named colors Trello uses for labels. Untyped string
+// constants convert implicitly, and other strings with Color(s).
+type Color string
+
+const (
+	ColorGreen  Color = "green"
+	ColorYellow Color = "yellow"
+	ColorOrange Color = "orange"
+	ColorRed    Color = "red"
+	ColorPurple Color = "purple"
+	ColorBlue   Color = "blue"
+	ColorSky    Color = "sky"
+	ColorLime   Color = "lime"
+	ColorPink   Color = "pink"
+	ColorBlack  Color = "black"
+)
+
+// labelColors is the set of colors Trello allows for board labels.
+var labelColors = map[Color]bool{
+	ColorGreen:  true,
+	ColorYellow: true,
+	ColorOrange: true,
+	ColorRed:    true,
+	ColorPurple: true,
+	ColorBlue:   true,
+	ColorSky:    true,
+	ColorLime:   true,
+	ColorPink:   true,
+	ColorBlack:  true,
+}
+
+// Valid reports whether c is one of Trello's label colors.
+func (c Color) Valid() bool {
+	return labelColors[c]
+}
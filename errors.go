@@ -0,0 +1,39 @@
+package trello
+
+import (
+	"errors"
+	"net/http"
+)
+
+// ErrUnauthorized matches, via errors.Is, the *APIError returned for a
+// 401 response, such as when the token is invalid or has expired.
+var ErrUnauthorized = errors.New("trello: unauthorized")
+
+// APIError is returned when Trello responds with a non-2xx status.
+type APIError struct {
+	// Status is the response status, such as "401 Unauthorized".
+	Status string
+
+	// Body is the response body, which usually holds Trello's
+	// explanation of the failure.
+	Body string
+
+	statusCode int
+}
+
+func (e *APIError) Error() string {
+	msg := "bad response code: " + e.Status
+	if len(e.Body) > 0 {
+		msg += ": " + e.Body
+	}
+	return msg
+}
+
+// StatusCode returns the response's HTTP status code.
+func (e *APIError) StatusCode() int {
+	return e.statusCode
+}
+
+func (e *APIError) Is(target error) bool {
+	return target == ErrUnauthorized && e.statusCode == http.StatusUnauthorized
+}
@@ -0,0 +1,137 @@
+package trello
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// ImportFailure is an item from an exported board that Import couldn't
+// recreate.
+type ImportFailure struct {
+	// Kind is "list" or "card".
+	Kind string
+	Name string
+	Err  error
+}
+
+// ImportError is returned by BoardService.Import, along with the new
+// board, when some lists or cards couldn't be recreated.
+type ImportError struct {
+	Failures []ImportFailure
+}
+
+func (e *ImportError) Error() string {
+	if len(e.Failures) == 0 {
+		return "import: no failures"
+	}
+
+	f := e.Failures[0]
+	msg := fmt.Sprintf("import: %s %q: %v", f.Kind, f.Name, f.Err)
+	if len(e.Failures) > 1 {
+		msg += fmt.Sprintf(" (and %d more failures)", len(e.Failures)-1)
+	}
+	return msg
+}
+
+// exportedBoard is the part of Board.Export's JSON that Import restores.
+type exportedBoard struct {
+	Lists []struct {
+		ID     string  `json:"id"`
+		Name   string  `json:"name"`
+		Closed bool    `json:"closed"`
+		Pos    float64 `json:"pos"`
+	} `json:"lists"`
+	Cards []struct {
+		Name   string  `json:"name"`
+		Desc   string  `json:"desc"`
+		IDList string  `json:"idList"`
+		Closed bool    `json:"closed"`
+		Pos    float64 `json:"pos"`
+		Due    *string `json:"due"`
+	} `json:"cards"`
+}
+
+func (b *boardService) Import(data []byte, name string) (Board, error) {
+	var exported exportedBoard
+	if err := json.Unmarshal(data, &exported); err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	var d = board{
+		client: b.client,
+	}
+	body := map[string]interface{}{
+		"name":         name,
+		"defaultLists": false,
+	}
+	if err := b.client.doJSON(ctx, "POST", "/1/boards", body, &d); err != nil {
+		return nil, err
+	}
+
+	var failures []ImportFailure
+
+	sort.SliceStable(exported.Lists, func(i, j int) bool {
+		return exported.Lists[i].Pos < exported.Lists[j].Pos
+	})
+	listIDs := make(map[string]string)
+	closedLists := make(map[string]bool)
+	for _, l := range exported.Lists {
+		if l.Closed {
+			closedLists[l.ID] = true
+			continue
+		}
+
+		var created list
+		body := map[string]interface{}{
+			"name":    l.Name,
+			"idBoard": d.ID,
+			"pos":     "bottom",
+		}
+		if err := b.client.doJSON(ctx, "POST", "/1/lists", body, &created); err != nil {
+			failures = append(failures, ImportFailure{Kind: "list", Name: l.Name, Err: err})
+			continue
+		}
+		listIDs[l.ID] = created.ID
+	}
+
+	sort.SliceStable(exported.Cards, func(i, j int) bool {
+		return exported.Cards[i].Pos < exported.Cards[j].Pos
+	})
+	for _, c := range exported.Cards {
+		// cards on archived lists go with their list
+		if c.Closed || closedLists[c.IDList] {
+			continue
+		}
+
+		idList, ok := listIDs[c.IDList]
+		if !ok {
+			failures = append(failures, ImportFailure{
+				Kind: "card",
+				Name: c.Name,
+				Err:  fmt.Errorf("list %s wasn't imported", c.IDList),
+			})
+			continue
+		}
+
+		body := map[string]interface{}{
+			"name":   c.Name,
+			"desc":   c.Desc,
+			"idList": idList,
+			"pos":    "bottom",
+		}
+		if c.Due != nil {
+			body["due"] = *c.Due
+		}
+		if err := b.client.doJSON(ctx, "POST", "/1/cards", body, nil); err != nil {
+			failures = append(failures, ImportFailure{Kind: "card", Name: c.Name, Err: err})
+		}
+	}
+
+	if len(failures) > 0 {
+		return &d, &ImportError{Failures: failures}
+	}
+	return &d, nil
+}
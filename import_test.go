@@ -0,0 +1,36 @@
+package trello
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestImportSkipsCardsOnArchivedLists(t *testing.T) {
+	var cardsCreated int
+	c := stubClient(t, func(r *http.Request) (int, string) {
+		switch r.URL.Path {
+		case "/1/cards":
+			cardsCreated++
+		case "/1/lists":
+			return http.StatusOK, `{"id":"newlist"}`
+		}
+		return http.StatusOK, `{"id":"new"}`
+	})
+
+	data := `{
+		"lists": [{"id":"open","name":"Open"}, {"id":"old","name":"Old","closed":true}],
+		"cards": [{"name":"a","idList":"open"}, {"name":"b","idList":"old"}]
+	}`
+	if _, err := c.BoardService().Import([]byte(data), "Copy"); err != nil {
+		t.Fatalf("Import() error = %v, want nil", err)
+	}
+	if cardsCreated != 1 {
+		t.Errorf("created %d cards, want 1", cardsCreated)
+	}
+}
+
+func TestImportErrorEmpty(t *testing.T) {
+	if msg := (&ImportError{}).Error(); len(msg) == 0 {
+		t.Error("empty ImportError has no message")
+	}
+}
@@ -0,0 +1,21 @@
+package trello
+
+import "strconv"
+
+// Trello orders lists and cards by ascending position. Besides "top" and
+// "bottom", any positive number can be passed as a position; items with
+// equal positions fall back to an order Trello doesn't guarantee, so
+// give each item its own position when creating several at once.
+
+// PositionBetween returns a position that sorts between a and b, which
+// can be used to insert an item between two existing ones without
+// moving either of them.
+func PositionBetween(a, b float64) float64 {
+	return a + (b-a)/2
+}
+
+// FormatPosition formats a numeric position for APIs that take the
+// position as a string, such as ListService.Create.
+func FormatPosition(pos float64) string {
+	return strconv.FormatFloat(pos, 'f', -1, 64)
+}
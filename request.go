@@ -0,0 +1,166 @@
+package trello
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const baseURL = "https://api.trello.com"
+
+// ErrDryRun is returned by every call made with a client created using
+// WithDryRun.
+var ErrDryRun = errors.New("trello: dry run, request not sent")
+
+// do issues a request for path against the Trello API, adding the
+// client's credentials to params, and decodes a successful response into
+// out when out is non-nil.
+func (c *client) do(method, path string, params url.Values, out interface{}) error {
+	return c.doContext(context.Background(), method, path, params, out)
+}
+
+// doContext is like do, but the request is cancelled when ctx is done.
+func (c *client) doContext(ctx context.Context, method, path string, params url.Values, out interface{}) error {
+	return c.roundTrip(ctx, method, path, params, nil, out)
+}
+
+// doJSON is like doContext, but sends body JSON-encoded as the request
+// body instead of as query parameters, for writes whose parameters are
+// too large or too structured for a URL. Only the credentials go in the
+// query string.
+func (c *client) doJSON(ctx context.Context, method, path string, body, out interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return c.roundTrip(ctx, method, path, nil, data, out)
+}
+
+// roundTrip sends a request with params in the query string and, when
+// body is non-nil, body as its JSON payload.
+func (c *client) roundTrip(ctx context.Context, method, path string, params url.Values, body []byte, out interface{}) error {
+	// copy params so the caller's values don't pick up our credentials
+	query := url.Values{}
+	for k, v := range params {
+		query[k] = v
+	}
+	query.Set("key", c.key)
+	if len(c.token) > 0 {
+		query.Set("token", c.token)
+	}
+	restURL := baseURL + path + "?" + query.Encode()
+
+	if c.callTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.callTimeout)
+		defer cancel()
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		method,
+		restURL,
+		reqBody,
+	)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.dryRun != nil {
+		c.dryRun(req)
+		return ErrDryRun
+	}
+
+	hc := c.httpClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	send := RoundTripFunc(hc.Do)
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		send = c.middleware[i](send)
+	}
+
+	var resp *http.Response
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && body != nil {
+			req.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		resp, err = send(req)
+
+		retry := attempt < c.retryPolicy.attempts()
+		if err == nil {
+			retry = retry && c.retryPolicy.retryable(resp.StatusCode)
+		}
+		if !retry {
+			break
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		// don't back off past the caller's deadline only to fail then
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		delay := c.retryPolicy.delay(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return context.DeadlineExceeded
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return &APIError{
+			Status:     resp.Status,
+			Body:       strings.TrimSpace(string(body)),
+			statusCode: resp.StatusCode,
+		}
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// RoundTripFunc sends a single HTTP request to Trello.
+type RoundTripFunc func(*http.Request) (*http.Response, error)
+
+// Middleware wraps the function the client uses to send each request,
+// letting callers observe or modify requests and their responses.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// WithMiddleware adds middleware around every request the client sends.
+// The first middleware given is the outermost. Middleware runs inside the
+// retry loop, so each retried attempt passes through it again, and it
+// wraps the HTTP client set with WithHTTPClient. Requests made in dry-run
+// mode never reach it.
+func WithMiddleware(mw ...Middleware) ClientOption {
+	return func(c *client) {
+		c.middleware = append(c.middleware, mw...)
+	}
+}
@@ -0,0 +1,63 @@
+package trello
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// stubClient returns a client whose requests are answered by respond,
+// which returns the status code and body to reply with.
+func stubClient(t *testing.T, respond func(*http.Request) (int, string), opts ...ClientOption) *client {
+	t.Helper()
+
+	hc := &http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			code, body := respond(r)
+			return &http.Response{
+				StatusCode: code,
+				Status:     http.StatusText(code),
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Request:    r,
+			}, nil
+		}),
+	}
+	opts = append([]ClientOption{WithHTTPClient(hc)}, opts...)
+	return NewClient("key", "token", opts...).(*client)
+}
+
+func TestRetryStopsAtContextDeadline(t *testing.T) {
+	var attempts int32
+	c := stubClient(t, func(r *http.Request) (int, string) {
+		atomic.AddInt32(&attempts, 1)
+		return http.StatusTooManyRequests, ""
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := c.doContext(ctx, "GET", "/1/boards/abc", nil, nil)
+	elapsed := time.Since(start)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed >= 500*time.Millisecond {
+		t.Errorf("call took %v, want well under the 1s base delay", elapsed)
+	}
+	if n := atomic.LoadInt32(&attempts); n != 1 {
+		t.Errorf("sent %d attempts, want 1", n)
+	}
+}
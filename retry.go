@@ -0,0 +1,74 @@
+package trello
+
+import (
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy controls how the client retries failed requests. The zero
+// value disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts per call, including the
+	// first; values below 2 disable retries.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry; it doubles on every
+	// further retry, up to MaxDelay when MaxDelay is positive.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	// Jitter is the fraction, between 0 and 1, of each delay that is
+	// randomised so that concurrent callers don't retry in lockstep.
+	Jitter float64
+
+	// Retryable reports whether a response with the given status code
+	// should be retried. If nil, 429 and 5xx responses are retried.
+	// Requests that fail without a response are always retried.
+	Retryable func(statusCode int) bool
+}
+
+// WithRetryPolicy makes the client retry failed requests according to p.
+func WithRetryPolicy(p RetryPolicy) ClientOption {
+	return func(c *client) {
+		c.retryPolicy = p
+	}
+}
+
+func (p RetryPolicy) attempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+func (p RetryPolicy) retryable(statusCode int) bool {
+	if p.Retryable != nil {
+		return p.Retryable(statusCode)
+	}
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// delay returns how long to wait before the given retry, counting the
+// first retry as 1.
+func (p RetryPolicy) delay(retry int) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < retry; i++ {
+		d *= 2
+		if p.MaxDelay > 0 && d >= p.MaxDelay {
+			break
+		}
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+
+	if p.Jitter > 0 && d > 0 {
+		jitter := p.Jitter
+		if jitter > 1 {
+			jitter = 1
+		}
+		d -= time.Duration(rand.Float64() * jitter * float64(d))
+	}
+	return d
+}
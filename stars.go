@@ -0,0 +1,41 @@
+package trello
+
+import (
+	"sync"
+	"time"
+)
+
+// boardStarsTTL is how long the authenticated member's board stars are
+// reused before Board.IsStarred fetches them again.
+const boardStarsTTL = time.Minute
+
+// boardStarCache holds the ids of the boards the authenticated member has
+// starred. It is shared by every copy of a client.
+type boardStarCache struct {
+	mu      sync.Mutex
+	fetched time.Time
+	starred map[string]bool
+}
+
+func (c *client) isBoardStarred(boardID string) (bool, error) {
+	cache := c.boardStars
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.starred == nil || time.Since(cache.fetched) > boardStarsTTL {
+		var stars []struct {
+			IDBoard string `json:"idBoard"`
+		}
+		if err := c.do("GET", "/1/members/me/boardStars", nil, &stars); err != nil {
+			return false, err
+		}
+
+		cache.starred = make(map[string]bool, len(stars))
+		for _, star := range stars {
+			cache.starred[star.IDBoard] = true
+		}
+		cache.fetched = time.Now()
+	}
+
+	return cache.starred[boardID], nil
+}
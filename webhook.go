@@ -0,0 +1,88 @@
+package trello
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"time"
+)
+
+// VerifyWebhookSignature reports whether header, the value of a webhook
+// request's X-Trello-Webhook header, was produced by Trello for body.
+// secret is the application secret and callbackURL is the callback URL
+// exactly as it was given when the webhook was created.
+//
+// Trello signs a callback with the base64-encoded HMAC-SHA1 of the
+// request body followed by the callback URL.
+func VerifyWebhookSignature(secret, callbackURL string, body []byte, header string) bool {
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write(body)
+	mac.Write([]byte(callbackURL))
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(expected), []byte(header))
+}
+
+// Webhook is a Trello webhook, which calls CallbackURL whenever the model
+// with id IDModel changes.
+type Webhook struct {
+	client *client `json:"-"`
+
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	IDModel     string `json:"idModel"`
+	CallbackURL string `json:"callbackURL"`
+	Active      bool   `json:"active"`
+}
+
+func (c *client) createWebhook(idModel, callbackURL, desc string) (Webhook, error) {
+	params := url.Values{
+		"idModel":     {idModel},
+		"callbackURL": {callbackURL},
+	}
+	if len(desc) > 0 {
+		params.Set("description", desc)
+	}
+
+	var w = Webhook{
+		client: c,
+	}
+	if err := c.do("POST", "/1/webhooks", params, &w); err != nil {
+		return Webhook{}, err
+	}
+	return w, nil
+}
+
+// webhookPollInterval is how often WaitActive checks the webhook.
+var webhookPollInterval = 2 * time.Second
+
+// WaitActive polls the webhook until Trello reports it active, returning
+// ctx's error if ctx is done first. It only works on webhooks returned by
+// this package.
+func (w *Webhook) WaitActive(ctx context.Context) error {
+	if w.client == nil {
+		return errors.New("trello: webhook has no client to poll with")
+	}
+
+	for {
+		var d = Webhook{
+			client: w.client,
+		}
+		if err := w.client.doContext(ctx, "GET", "/1/webhooks/"+w.ID, nil, &d); err != nil {
+			return err
+		}
+		*w = d
+		if w.Active {
+			return nil
+		}
+
+		select {
+		case <-time.After(webhookPollInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package trello
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	const (
+		secret      = "secret"
+		callbackURL = "https://example.com/hook"
+		body        = `{"action":{"id":"1"}}`
+
+		// base64(HMAC-SHA1(secret, body+callbackURL))
+		signature = "6MSyXiqaKkXjnmrH2j1qhVcSSuw="
+		// base64(HMAC-SHA1(secret, callbackURL+body)), the wrong order
+		reversed = "EL7V3QEKlI751CJSaNKtL4yaYI0="
+	)
+
+	tests := []struct {
+		name        string
+		secret      string
+		callbackURL string
+		body        string
+		header      string
+		want        bool
+	}{
+		{"valid", secret, callbackURL, body, signature, true},
+		{"url before body", secret, callbackURL, body, reversed, false},
+		{"tampered body", secret, callbackURL, `{"action":{"id":"2"}}`, signature, false},
+		{"wrong url", secret, "https://example.com/other", body, signature, false},
+		{"wrong secret", "other", callbackURL, body, signature, false},
+		{"empty header", secret, callbackURL, body, "", false},
+	}
+
+	for _, tt := range tests {
+		got := VerifyWebhookSignature(tt.secret, tt.callbackURL, []byte(tt.body), tt.header)
+		if got != tt.want {
+			t.Errorf("%s: VerifyWebhookSignature() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCreateWebhookModel(t *testing.T) {
+	var query url.Values
+	c := NewClient("key", "token", WithDryRun(func(r *http.Request) {
+		query = r.URL.Query()
+	})).(*client)
+
+	b := &board{client: c, ID: "b1"}
+	if _, err := b.CreateWebhook("https://example.com/hook", "board hook"); err != ErrDryRun {
+		t.Fatalf("CreateWebhook() error = %v, want ErrDryRun", err)
+	}
+	if query.Get("idModel") != "b1" || query.Get("callbackURL") != "https://example.com/hook" ||
+		query.Get("description") != "board hook" {
+		t.Errorf("board webhook sent %v", query)
+	}
+
+	l := &list{client: c, ID: "l1"}
+	l.CreateWebhook("https://example.com/hook", "")
+	if query.Get("idModel") != "l1" {
+		t.Errorf("list webhook sent idModel %q, want l1", query.Get("idModel"))
+	}
+}
+
+func TestWebhookWaitActive(t *testing.T) {
+	orig := webhookPollInterval
+	defer func() { webhookPollInterval = orig }()
+	webhookPollInterval = time.Millisecond
+
+	var polls int
+	c := stubClient(t, func(r *http.Request) (int, string) {
+		polls++
+		if polls < 3 {
+			return http.StatusOK, `{"id":"w1","active":false}`
+		}
+		return http.StatusOK, `{"id":"w1","active":true}`
+	})
+
+	w := &Webhook{client: c, ID: "w1"}
+	if err := w.WaitActive(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !w.Active || polls != 3 {
+		t.Errorf("active = %v after %d polls, want true after 3", w.Active, polls)
+	}
+}